@@ -0,0 +1,94 @@
+//go:build windows
+
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/MuiGoku123432/windows-dev-setup/internal/deploy"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/manifest"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/pkg/tui"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/runner"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var allCmd = &cobra.Command{
+	Use:   "all",
+	Short: "Run the full install, deploy, git-config and verify flow (the default)",
+	RunE:  runAll,
+}
+
+func runAll(cmd *cobra.Command, args []string) error {
+	fmt.Println()
+	fmt.Printf("%s========================================%s\n", ui.ColorMagenta, ui.ColorReset)
+	fmt.Printf("%s  Windows Dev Environment Setup%s\n", ui.ColorMagenta, ui.ColorReset)
+	fmt.Printf("%s========================================%s\n", ui.ColorMagenta, ui.ColorReset)
+
+	startRun()
+	if !preflight() {
+		return fmt.Errorf("preflight checks failed")
+	}
+
+	m, steps, err := loadManifest()
+	if err != nil {
+		return err
+	}
+
+	if interactive {
+		if err := runInteractive(steps); err != nil {
+			return err
+		}
+	} else {
+		runner.RunSteps(scriptRoot, steps)
+	}
+
+	ui.Step("Configuring Git defaults")
+	runner.ApplyGitConfig(m.GitConfigs)
+
+	ui.Step("Verifying installations")
+	runner.VerifyTable(steps)
+
+	printSummary()
+
+	fmt.Println()
+	fmt.Printf("%sNext steps:%s\n", ui.ColorCyan, ui.ColorReset)
+	fmt.Println("  1. Open WezTerm - it launches Nushell automatically")
+	fmt.Println("  2. Run 'nvim' to trigger first-time LazyVim plugin install (~1-2 min)")
+	fmt.Println("  3. Customize configs in this repo's configs/ directory, re-run setup to apply")
+	fmt.Println()
+	return nil
+}
+
+// runInteractive lets the user pick which tools to install and which config
+// files to deploy, independently, then runs just those.
+func runInteractive(steps []manifest.Step) error {
+	selectedSteps, err := tui.SelectSteps(steps)
+	if err != nil {
+		return fmt.Errorf("tool selection cancelled: %w", err)
+	}
+	selectedDeploys, err := tui.SelectDeploys(steps)
+	if err != nil {
+		return fmt.Errorf("config selection cancelled: %w", err)
+	}
+
+	for i, step := range selectedSteps {
+		ui.Step(fmt.Sprintf("Step %d/%d: %s", i+1, len(selectedSteps), step.Name))
+		runner.InstallStep(step)
+	}
+
+	if len(selectedDeploys) > 0 {
+		ui.Step("Deploying selected configuration files")
+		for _, d := range selectedDeploys {
+			root := scriptRoot
+			for _, s := range steps {
+				if s.Name == d.StepName && s.PluginRoot != "" {
+					root = s.PluginRoot
+				}
+			}
+			deploy.File(filepath.Join(root, d.Deploy.Source), runner.ExpandPathVars(d.Deploy.Target))
+		}
+	}
+	return nil
+}
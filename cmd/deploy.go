@@ -0,0 +1,63 @@
+//go:build windows
+
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/MuiGoku123432/windows-dev-setup/internal/deploy"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/pkg/tui"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/runner"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var deployOnly []string
+
+var deployCmd = &cobra.Command{
+	Use:   "deploy",
+	Short: "Deploy the config files declared in the manifest, without installing tools",
+	RunE:  runDeploy,
+}
+
+func init() {
+	deployCmd.Flags().StringSliceVar(&deployOnly, "only", nil,
+		"comma-separated step names whose config files should be deployed (default: all)")
+}
+
+func runDeploy(cmd *cobra.Command, args []string) error {
+	ui.ResetFailures()
+	_, steps, err := loadManifest()
+	if err != nil {
+		return err
+	}
+	steps = filterSteps(steps, deployOnly)
+	startRun()
+
+	if interactive {
+		choices, err := tui.SelectDeploys(steps)
+		if err != nil {
+			return fmt.Errorf("config selection cancelled: %w", err)
+		}
+		ui.Step("Deploying selected configuration files")
+		for _, d := range choices {
+			root := scriptRoot
+			for _, s := range steps {
+				if s.Name == d.StepName && s.PluginRoot != "" {
+					root = s.PluginRoot
+				}
+			}
+			deploy.File(filepath.Join(root, d.Deploy.Source), runner.ExpandPathVars(d.Deploy.Target))
+		}
+		printRunID()
+		return nil
+	}
+
+	ui.Step("Deploying configuration files")
+	for _, step := range steps {
+		runner.DeployStep(scriptRoot, step)
+	}
+	printRunID()
+	return nil
+}
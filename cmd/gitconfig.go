@@ -0,0 +1,29 @@
+//go:build windows
+
+package cmd
+
+import (
+	"github.com/MuiGoku123432/windows-dev-setup/internal/runner"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var gitConfigCmd = &cobra.Command{
+	Use:   "git-config",
+	Short: "Apply the git_config entries declared in the manifest",
+	RunE:  runGitConfig,
+}
+
+func runGitConfig(cmd *cobra.Command, args []string) error {
+	ui.ResetFailures()
+	m, _, err := loadManifest()
+	if err != nil {
+		return err
+	}
+
+	startRun()
+	ui.Step("Configuring Git defaults")
+	runner.ApplyGitConfig(m.GitConfigs)
+	printRunID()
+	return nil
+}
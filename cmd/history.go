@@ -0,0 +1,83 @@
+//go:build windows
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/MuiGoku123432/windows-dev-setup/internal/history"
+	"github.com/spf13/cobra"
+)
+
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Inspect what past runs installed, deployed, and configured",
+}
+
+var historyListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List recorded run IDs",
+	RunE:  runHistoryList,
+}
+
+var historyShowCmd = &cobra.Command{
+	Use:   "show <runid>",
+	Short: "Show every operation a run recorded",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runHistoryShow,
+}
+
+func init() {
+	historyCmd.AddCommand(historyListCmd)
+	historyCmd.AddCommand(historyShowCmd)
+}
+
+func runHistoryList(cmd *cobra.Command, args []string) error {
+	ids, err := history.List()
+	if err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		fmt.Println("No recorded runs.")
+		return nil
+	}
+	for _, id := range ids {
+		fmt.Println("  " + id)
+	}
+	return nil
+}
+
+func runHistoryShow(cmd *cobra.Command, args []string) error {
+	run, err := history.Load(args[0])
+	if err != nil {
+		return fmt.Errorf("loading run %s: %w", args[0], err)
+	}
+
+	fmt.Printf("Run %s (started %s)\n", run.ID, run.StartedAt.Format("2006-01-02 15:04:05"))
+	if len(run.Operations) == 0 {
+		fmt.Println("  (no operations recorded)")
+		return nil
+	}
+	for _, op := range run.Operations {
+		switch op.Type {
+		case history.OpPackage:
+			fmt.Printf("  [package]    %s (%s, %s)\n", op.Name, op.Backend, op.PackageID)
+		case history.OpDeploy:
+			fmt.Printf("  [deploy]     %s (backup: %s)\n", op.Target, backupOrNone(op.Backup))
+		case history.OpLazyVim:
+			fmt.Printf("  [lazyvim]    %s (backup: %s)\n", op.Target, backupOrNone(op.Backup))
+		case history.OpGitConfig:
+			fmt.Printf("  [git-config] %s = %s\n", op.Key, op.Value)
+		default:
+			fmt.Printf("  [%s] %s\n", op.Type, op.Name)
+		}
+	}
+	return nil
+}
+
+func backupOrNone(backup string) string {
+	if backup == "" {
+		return "none"
+	}
+	return backup
+}
@@ -0,0 +1,51 @@
+//go:build windows
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/MuiGoku123432/windows-dev-setup/internal/pkg/tui"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/runner"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var installOnly []string
+
+var installCmd = &cobra.Command{
+	Use:   "install",
+	Short: "Install the tools declared in the manifest",
+	RunE:  runInstall,
+}
+
+func init() {
+	installCmd.Flags().StringSliceVar(&installOnly, "only", nil,
+		"comma-separated step names to install (default: all steps)")
+}
+
+func runInstall(cmd *cobra.Command, args []string) error {
+	ui.ResetFailures()
+	startRun()
+	if !preflight() {
+		return fmt.Errorf("preflight checks failed")
+	}
+
+	_, steps, err := loadManifest()
+	if err != nil {
+		return err
+	}
+	steps = filterSteps(steps, installOnly)
+
+	if interactive {
+		selected, err := tui.SelectSteps(steps)
+		if err != nil {
+			return fmt.Errorf("tool selection cancelled: %w", err)
+		}
+		steps = selected
+	}
+
+	runner.RunSteps(scriptRoot, steps)
+	printRunID()
+	return nil
+}
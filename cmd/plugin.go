@@ -0,0 +1,94 @@
+//go:build windows
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/MuiGoku123432/windows-dev-setup/internal/plugin"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var pluginCmd = &cobra.Command{
+	Use:   "plugin",
+	Short: "Manage third-party install recipes in the plugins directory",
+}
+
+var pluginListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List discovered plugins",
+	RunE:  runPluginList,
+}
+
+var pluginInstallCmd = &cobra.Command{
+	Use:   "install <git-url>",
+	Short: "Clone a plugin into the plugins directory",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runPluginInstall,
+}
+
+var pluginRemoveCmd = &cobra.Command{
+	Use:   "remove <name>",
+	Short: "Remove an installed plugin",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runPluginRemove,
+}
+
+func init() {
+	pluginCmd.AddCommand(pluginListCmd)
+	pluginCmd.AddCommand(pluginInstallCmd)
+	pluginCmd.AddCommand(pluginRemoveCmd)
+}
+
+func runPluginList(cmd *cobra.Command, args []string) error {
+	plugins := plugin.Discover()
+	if len(plugins) == 0 {
+		fmt.Println("No plugins installed.")
+		return nil
+	}
+	for _, p := range plugins {
+		fmt.Printf("  %s%-20s%s %s (%s)\n", ui.ColorWhite, p.Name, ui.ColorReset, p.Version, p.Root)
+	}
+	return nil
+}
+
+func runPluginInstall(cmd *cobra.Command, args []string) error {
+	url := args[0]
+	name := strings.TrimSuffix(filepath.Base(url), ".git")
+	dest := filepath.Join(plugin.Dir(), name)
+
+	if _, err := os.Stat(dest); err == nil {
+		return fmt.Errorf("plugin %s is already installed at %s", name, dest)
+	}
+	if err := os.MkdirAll(plugin.Dir(), 0755); err != nil {
+		return fmt.Errorf("cannot create plugins directory: %w", err)
+	}
+	if !ui.CommandExists("git") {
+		return fmt.Errorf("git not found - cannot clone plugin")
+	}
+
+	fmt.Printf("   %sCloning %s...%s\n", ui.ColorWhite, url, ui.ColorReset)
+	if err := ui.RunCmdPassthrough("git", "clone", url, dest); err != nil {
+		return fmt.Errorf("failed to clone %s: %w", url, err)
+	}
+	ui.Success("Plugin " + name + " installed")
+	return nil
+}
+
+func runPluginRemove(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	dest := filepath.Join(plugin.Dir(), name)
+
+	if _, err := os.Stat(dest); os.IsNotExist(err) {
+		return fmt.Errorf("plugin %s not found", name)
+	}
+	if err := os.RemoveAll(dest); err != nil {
+		return fmt.Errorf("failed to remove plugin %s: %w", name, err)
+	}
+	ui.Success("Plugin " + name + " removed")
+	return nil
+}
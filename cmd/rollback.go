@@ -0,0 +1,118 @@
+//go:build windows
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/MuiGoku123432/windows-dev-setup/internal/gitcfg"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/history"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/pkg/choco"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/pkg/scoop"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/pkg/winget"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var rollbackCmd = &cobra.Command{
+	Use:   "rollback <runid>",
+	Short: "Reverse exactly the operations recorded by a previous run (see `winsetup history list`)",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runRollback,
+}
+
+func runRollback(cmd *cobra.Command, args []string) error {
+	ui.ResetFailures()
+	runID := args[0]
+	run, err := history.Load(runID)
+	if err != nil {
+		return fmt.Errorf("loading run %s: %w", runID, err)
+	}
+	if len(run.Operations) == 0 {
+		ui.Skip("Nothing to roll back")
+		return nil
+	}
+
+	ui.Step("Rolling back run " + runID)
+	for i := len(run.Operations) - 1; i >= 0; i-- {
+		rollbackOp(run.Operations[i])
+	}
+
+	if len(ui.Failures()) > 0 {
+		fmt.Println()
+		fmt.Printf("%sRun %s was not fully rolled back - fix the issue(s) above and re-run `winsetup rollback %s`.%s\n",
+			ui.ColorYellow, runID, runID, ui.ColorReset)
+		return nil
+	}
+	return history.Remove(runID)
+}
+
+// rollbackOp reverses a single recorded operation. Operations are never
+// recorded for steps that were already satisfied (ui.Skip), so this only
+// ever undoes something this tool actually did.
+func rollbackOp(op history.Operation) {
+	switch op.Type {
+	case history.OpPackage:
+		rollbackPackage(op)
+	case history.OpDeploy, history.OpLazyVim:
+		rollbackBackup(op)
+	case history.OpGitConfig:
+		gitcfg.Unset(op.Key)
+		ui.Success("Unset git " + op.Key)
+	default:
+		ui.Fail(fmt.Sprintf("%s: unknown operation type %q", op.Name, op.Type))
+	}
+}
+
+func rollbackPackage(op history.Operation) {
+	var err error
+	switch op.Backend {
+	case "winget":
+		err = winget.Uninstall(op.PackageID)
+	case "scoop":
+		err = scoop.Uninstall(op.PackageID)
+	case "choco":
+		err = choco.Uninstall(op.PackageID)
+	default:
+		ui.Skip(fmt.Sprintf("%s: no uninstall available for backend %q, leaving it in place", op.Name, op.Backend))
+		return
+	}
+
+	if err != nil {
+		ui.Fail("Failed to uninstall " + op.Name + ": " + err.Error())
+		return
+	}
+	ui.Success(op.Name + " uninstalled")
+}
+
+// rollbackBackup restores a deploy or LazyVim clone: if Backup is set it
+// puts the original back in place, otherwise this run created Target from
+// nothing and rollback just removes it.
+func rollbackBackup(op history.Operation) {
+	if op.Backup == "" {
+		if err := os.RemoveAll(op.Target); err != nil {
+			ui.Fail("Failed to remove " + op.Target + ": " + err.Error())
+			return
+		}
+		ui.Success("Removed " + op.Target)
+		return
+	}
+
+	info, err := os.Stat(op.Backup)
+	if err != nil {
+		ui.Fail(fmt.Sprintf("Backup missing for %s: %s", op.Target, op.Backup))
+		return
+	}
+	if info.IsDir() {
+		if err := os.RemoveAll(op.Target); err != nil {
+			ui.Fail("Failed to remove " + op.Target + ": " + err.Error())
+			return
+		}
+	}
+	if err := os.Rename(op.Backup, op.Target); err != nil {
+		ui.Fail("Failed to restore " + op.Target + ": " + err.Error())
+		return
+	}
+	ui.Success("Restored " + op.Target)
+}
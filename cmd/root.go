@@ -0,0 +1,216 @@
+//go:build windows
+
+// Package cmd implements the winsetup command tree: install, deploy,
+// verify, rollback and git-config as slices of the same manifest-driven
+// flow that `winsetup` (no subcommand) runs end to end.
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/MuiGoku123432/windows-dev-setup/internal/backend"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/history"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/manifest"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/pkg/choco"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/pkg/scoop"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/pkg/tui"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/plugin"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	manifestPath string
+	scriptRoot   string
+	interactive  bool
+	dryRun       bool
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "winsetup",
+	Short: "Windows dev environment setup",
+	Long:  "winsetup installs and configures this team's standard Windows dev environment.",
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		ui.DryRun = dryRun
+	},
+	RunE: runAll,
+}
+
+// Execute runs the command tree. It's the only entry point main() needs.
+func Execute() error {
+	ui.EnableVirtualTerminal()
+
+	exePath, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("cannot determine executable path: %w", err)
+	}
+	scriptRoot = filepath.Dir(exePath)
+
+	return rootCmd.Execute()
+}
+
+// preflight checks the prerequisites every install path depends on:
+// winget on PATH and a reachable github.com. It prints its own errors and
+// returns false when the caller should stop.
+func preflight() bool {
+	ui.Step("Running preflight checks")
+
+	if !ui.CommandExists("winget") {
+		fmt.Println()
+		fmt.Printf("%sERROR: winget is not available.%s\n", ui.ColorRed, ui.ColorReset)
+		fmt.Printf("%sInstall 'App Installer' from the Microsoft Store, then re-run this program.%s\n", ui.ColorRed, ui.ColorReset)
+		fmt.Printf("%shttps://apps.microsoft.com/detail/9NBLGGH4NNS1%s\n", ui.ColorYellow, ui.ColorReset)
+		return false
+	}
+	ui.Success("winget found")
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Get("https://www.github.com")
+	if err != nil {
+		fmt.Println()
+		fmt.Printf("%sERROR: Cannot reach github.com. Check your internet connection.%s\n", ui.ColorRed, ui.ColorReset)
+		return false
+	}
+	resp.Body.Close()
+	ui.Success("Internet connectivity OK")
+
+	available := backend.Detect()
+	for _, name := range []string{"winget", "scoop", "choco"} {
+		if available[name] {
+			ui.Success(name + " backend available")
+			continue
+		}
+		if !ui.DryRun && bootstrapBackend(name) {
+			ui.Success(name + " backend available")
+			continue
+		}
+		ui.Skip(name + " backend not found")
+	}
+	return true
+}
+
+// bootstrapBackend offers to install a missing scoop/choco backend (winget
+// is already required by this point) and reports whether it's available
+// afterwards. It's a no-op prompt the user can decline. Callers must check
+// ui.DryRun first - bootstrapping always touches the system for real.
+func bootstrapBackend(name string) bool {
+	switch name {
+	case "scoop":
+		if !tui.Confirm("Scoop backend not found - install it now?") {
+			return false
+		}
+		scoop.Bootstrap()
+		return ui.CommandExists("scoop")
+	case "choco":
+		if !tui.Confirm("Chocolatey backend not found - install it now?") {
+			return false
+		}
+		choco.Bootstrap()
+		return ui.CommandExists("choco")
+	default:
+		return false
+	}
+}
+
+// startRun begins a new history run unless --dry-run is set, so the
+// install/deploy/git-config helpers that follow have something to record
+// into. Commands that only read (verify, plugin list, history, rollback)
+// don't call this.
+func startRun() {
+	if !dryRun {
+		history.StartRun()
+	}
+}
+
+// printRunID prints the current run's ID, if it recorded anything worth
+// rolling back.
+func printRunID() {
+	if history.Current != nil && len(history.Current.Operations) > 0 {
+		fmt.Printf("%sRun ID: %s%s (use this with `winsetup rollback` or `winsetup history show`)\n",
+			ui.ColorCyan, history.Current.ID, ui.ColorReset)
+	}
+}
+
+// printSummary prints the closing banner listing accumulated failures, if
+// any, matching the output the full flow has always ended with.
+func printSummary() {
+	fmt.Println()
+	printRunID()
+
+	failures := ui.Failures()
+	if len(failures) > 0 {
+		fmt.Printf("%s========================================%s\n", ui.ColorRed, ui.ColorReset)
+		fmt.Printf("%s  Completed with %d failure(s):%s\n", ui.ColorRed, len(failures), ui.ColorReset)
+		fmt.Printf("%s========================================%s\n", ui.ColorRed, ui.ColorReset)
+		for _, fail := range failures {
+			fmt.Printf("  %s- %s%s\n", ui.ColorRed, fail, ui.ColorReset)
+		}
+		fmt.Println()
+		return
+	}
+
+	fmt.Printf("%s========================================%s\n", ui.ColorGreen, ui.ColorReset)
+	fmt.Printf("%s  All done! No failures.%s\n", ui.ColorGreen, ui.ColorReset)
+	fmt.Printf("%s========================================%s\n", ui.ColorGreen, ui.ColorReset)
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&manifestPath, "manifest", "",
+		"path to a setup manifest (TOML); defaults to the embedded profile")
+	rootCmd.PersistentFlags().BoolVarP(&interactive, "interactive", "i", false,
+		"pick which tools and config files to install/deploy instead of running everything")
+	rootCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false,
+		"print what would be installed/deployed without changing anything")
+
+	rootCmd.AddCommand(allCmd)
+	rootCmd.AddCommand(installCmd)
+	rootCmd.AddCommand(deployCmd)
+	rootCmd.AddCommand(verifyCmd)
+	rootCmd.AddCommand(rollbackCmd)
+	rootCmd.AddCommand(gitConfigCmd)
+	rootCmd.AddCommand(pluginCmd)
+	rootCmd.AddCommand(historyCmd)
+}
+
+// loadManifest reads the manifest selected by --manifest, merges in any
+// plugins found in the plugins directory, and dependency-orders the
+// result, failing the command if it can't be parsed.
+func loadManifest() (*manifest.Manifest, []manifest.Step, error) {
+	m, err := manifest.Load(manifestPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	merged := plugin.Merge(m.Steps, plugin.Discover())
+
+	steps, err := manifest.Order(merged)
+	if err != nil {
+		return nil, nil, err
+	}
+	return m, steps, nil
+}
+
+// filterSteps returns only the steps whose name appears in only, or every
+// step when only is empty.
+func filterSteps(steps []manifest.Step, only []string) []manifest.Step {
+	if len(only) == 0 {
+		return steps
+	}
+
+	want := make(map[string]bool, len(only))
+	for _, name := range only {
+		want[name] = true
+	}
+
+	var filtered []manifest.Step
+	for _, s := range steps {
+		if want[s.Name] {
+			filtered = append(filtered, s)
+		}
+	}
+	return filtered
+}
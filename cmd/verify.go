@@ -0,0 +1,26 @@
+//go:build windows
+
+package cmd
+
+import (
+	"github.com/MuiGoku123432/windows-dev-setup/internal/runner"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var verifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Print installed versions for every tool in the manifest",
+	RunE:  runVerify,
+}
+
+func runVerify(cmd *cobra.Command, args []string) error {
+	ui.ResetFailures()
+	_, steps, err := loadManifest()
+	if err != nil {
+		return err
+	}
+
+	runner.VerifyTable(steps)
+	return nil
+}
@@ -0,0 +1,153 @@
+//go:build windows
+
+// Package backend abstracts over the package managers windows-dev-setup
+// can install through, so a manifest step can list several in priority
+// order and fall back automatically when the preferred one is missing or
+// fails.
+package backend
+
+import (
+	"fmt"
+
+	"github.com/MuiGoku123432/windows-dev-setup/internal/history"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/manifest"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/pkg/choco"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/pkg/direct"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/pkg/scoop"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/pkg/winget"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/ui"
+)
+
+// Options carries the per-backend fields a BackendCandidate may set.
+type Options struct {
+	Bucket string
+	URL    string
+	SHA256 string
+}
+
+// PackageBackend is one way to get a package onto the machine.
+type PackageBackend interface {
+	Name() string
+	IsAvailable() bool
+	IsInstalled(id string) bool
+	Install(id string, opts Options) error
+}
+
+// Get returns the backend registered under name, or false if name isn't
+// one of winget/scoop/choco/direct.
+func Get(name string) (PackageBackend, bool) {
+	switch name {
+	case "winget":
+		return wingetBackend{}, true
+	case "scoop":
+		return scoopBackend{}, true
+	case "choco":
+		return chocoBackend{}, true
+	case "direct":
+		return directBackend{}, true
+	default:
+		return nil, false
+	}
+}
+
+type wingetBackend struct{}
+
+func (wingetBackend) Name() string               { return "winget" }
+func (wingetBackend) IsAvailable() bool          { return ui.CommandExists("winget") }
+func (wingetBackend) IsInstalled(id string) bool { return winget.IsInstalled(id) }
+func (wingetBackend) Install(id string, _ Options) error {
+	return winget.InstallSilent(id, "")
+}
+
+type scoopBackend struct{}
+
+func (scoopBackend) Name() string               { return "scoop" }
+func (scoopBackend) IsAvailable() bool          { return ui.CommandExists("scoop") }
+func (scoopBackend) IsInstalled(id string) bool { return scoop.IsInstalled(id) }
+func (scoopBackend) Install(id string, opts Options) error {
+	return scoop.InstallSilent(id, opts.Bucket, "")
+}
+
+type chocoBackend struct{}
+
+func (chocoBackend) Name() string               { return "choco" }
+func (chocoBackend) IsAvailable() bool          { return ui.CommandExists("choco") }
+func (chocoBackend) IsInstalled(id string) bool { return choco.IsInstalled(id) }
+func (chocoBackend) Install(id string, _ Options) error {
+	return choco.InstallSilent(id)
+}
+
+// directBackend downloads an installer URL and runs it silently. It has
+// no package registry to query, so IsInstalled always reports false -
+// callers that care about skipping an already-installed tool should check
+// the step's own VerifyCmd first.
+type directBackend struct{}
+
+func (directBackend) Name() string               { return "direct" }
+func (directBackend) IsAvailable() bool          { return true }
+func (directBackend) IsInstalled(id string) bool { return false }
+func (directBackend) Install(_ string, opts Options) error {
+	return direct.Install(opts.URL, opts.SHA256)
+}
+
+// InstallCandidates tries each candidate backend in order, stopping at
+// the first one that's available and either already has the package
+// installed or installs it successfully. It reports its own progress via
+// internal/ui, matching the install helpers in internal/pkg/*.
+//
+// verifyCmd is the step's own post-install check (e.g. "7z"); it's
+// consulted up front because directBackend.IsInstalled always reports
+// false (it has no package registry to query), so a candidate chain that
+// bottoms out at direct would otherwise redownload and reinstall on
+// every run even once the tool is present.
+func InstallCandidates(candidates []manifest.BackendCandidate, displayName, verifyCmd string) {
+	if verifyCmd != "" && ui.CommandExists(verifyCmd) {
+		ui.Skip(displayName + " already installed")
+		return
+	}
+
+	var tried []string
+
+	for _, c := range candidates {
+		b, ok := Get(c.Backend)
+		if !ok {
+			ui.Fail(fmt.Sprintf("%s: unknown backend %q", displayName, c.Backend))
+			continue
+		}
+		if !b.IsAvailable() {
+			continue
+		}
+
+		if b.IsInstalled(c.PackageID) {
+			ui.Skip(fmt.Sprintf("%s already installed (%s)", displayName, b.Name()))
+			return
+		}
+
+		fmt.Printf("   %sInstalling %s via %s...%s\n", ui.ColorWhite, displayName, b.Name(), ui.ColorReset)
+		opts := Options{Bucket: c.Bucket, URL: c.URL, SHA256: c.SHA256}
+		if err := b.Install(c.PackageID, opts); err == nil {
+			ui.RefreshPath()
+			ui.Success(fmt.Sprintf("%s installed (%s)", displayName, b.Name()))
+			history.Record(history.Operation{Type: history.OpPackage, Name: displayName, Backend: b.Name(), PackageID: c.PackageID})
+			return
+		}
+
+		tried = append(tried, b.Name())
+	}
+
+	if len(tried) == 0 {
+		ui.Fail(displayName + ": no candidate backend is available")
+		return
+	}
+	ui.Fail(fmt.Sprintf("%s: every available backend failed (tried %v)", displayName, tried))
+}
+
+// Detect reports which of the well-known backends are available on PATH,
+// for preflight to surface before any installs run.
+func Detect() map[string]bool {
+	return map[string]bool{
+		"winget": ui.CommandExists("winget"),
+		"scoop":  ui.CommandExists("scoop"),
+		"choco":  ui.CommandExists("choco"),
+	}
+}
@@ -0,0 +1,97 @@
+//go:build windows
+
+// Package deploy copies config files from this repo into place, backing
+// up and recording whatever they replace.
+package deploy
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/MuiGoku123432/windows-dev-setup/internal/history"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/ui"
+)
+
+// FileHash returns the hex SHA-256 of the file at path.
+func FileHash(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
+// File deploys sourcePath (resolved relative to scriptRoot by the caller)
+// to target, skipping the copy if target already matches by hash and
+// backing up whatever it replaces otherwise, recording the deploy in the
+// current history run if one is in progress. Under --dry-run it only
+// reports what it would have done.
+func File(sourcePath, target string) {
+	if _, err := os.Stat(sourcePath); os.IsNotExist(err) {
+		ui.Fail("Source config not found: " + sourcePath)
+		return
+	}
+
+	var backupPath string
+	if _, err := os.Stat(target); err == nil {
+		srcHash, err1 := FileHash(sourcePath)
+		tgtHash, err2 := FileHash(target)
+		if err1 == nil && err2 == nil && srcHash == tgtHash {
+			ui.Skip(target + " is up to date")
+			return
+		}
+
+		if ui.DryRun {
+			ui.Preview("Would back up and replace " + target)
+			return
+		}
+
+		timestamp := time.Now().Format("20060102-150405")
+		backupPath = target + ".bak." + timestamp
+		copyFile(target, backupPath)
+		fmt.Printf("   %sBacked up existing file to %s%s\n", ui.ColorYellow, backupPath, ui.ColorReset)
+	} else if ui.DryRun {
+		ui.Preview("Would deploy " + sourcePath + " -> " + target)
+		return
+	}
+
+	targetDir := filepath.Dir(target)
+	if err := os.MkdirAll(targetDir, 0755); err != nil {
+		ui.Fail("Failed to create directory: " + targetDir)
+		return
+	}
+
+	if err := copyFile(sourcePath, target); err != nil {
+		ui.Fail("Failed to deploy " + sourcePath + ": " + err.Error())
+		return
+	}
+	ui.Success("Deployed " + sourcePath + " -> " + target)
+	history.Record(history.Operation{Type: history.OpDeploy, Name: target, Target: target, Backup: backupPath})
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, in)
+	return err
+}
@@ -0,0 +1,35 @@
+//go:build windows
+
+// Package gitcfg sets `git config --global` keys this tool cares about,
+// without overwriting anything the user already configured.
+package gitcfg
+
+import (
+	"fmt"
+
+	"github.com/MuiGoku123432/windows-dev-setup/internal/history"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/ui"
+)
+
+// Get returns the current global value for key, or "" if unset.
+func Get(key string) string {
+	value, _ := ui.RunCmd("git", "config", "--global", "--get", key)
+	return value
+}
+
+// SetIfMissing sets key to value unless it's already configured.
+func SetIfMissing(key, value string) {
+	if current := Get(key); current != "" {
+		ui.Skip(fmt.Sprintf("git %s already set to '%s'", key, current))
+		return
+	}
+
+	ui.RunCmd("git", "config", "--global", key, value)
+	ui.Success(fmt.Sprintf("git %s set to '%s'", key, value))
+	history.Record(history.Operation{Type: history.OpGitConfig, Name: key, Key: key, Value: value})
+}
+
+// Unset clears key globally. Used by rollback to undo a key this tool set.
+func Unset(key string) {
+	ui.RunCmd("git", "config", "--global", "--unset", key)
+}
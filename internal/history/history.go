@@ -0,0 +1,136 @@
+//go:build windows
+
+// Package history records a transactional account of what one run of
+// winsetup actually changed - every package it installed, config file it
+// deployed, git config key it set, and the LazyVim clone it made - so that
+// `winsetup rollback <runid>` can reverse exactly those changes and
+// nothing else.
+package history
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// OpType identifies what kind of change an Operation represents.
+type OpType string
+
+const (
+	OpPackage   OpType = "package"    // a winget/scoop/choco/direct package this run installed
+	OpDeploy    OpType = "deploy"     // a config file this run deployed
+	OpGitConfig OpType = "git_config" // a git config key this run set
+	OpLazyVim   OpType = "lazyvim"    // the LazyVim starter this run cloned
+)
+
+// Operation is one reversible change a run made. Only the fields relevant
+// to Type are populated. A step that was already satisfied (ui.Skip) never
+// becomes an Operation, so rollback can never remove software this tool
+// didn't install.
+type Operation struct {
+	Type      OpType `json:"type"`
+	Name      string `json:"name"`
+	Backend   string `json:"backend,omitempty"`    // OpPackage
+	PackageID string `json:"package_id,omitempty"` // OpPackage
+	Target    string `json:"target,omitempty"`     // OpDeploy, OpLazyVim
+	Backup    string `json:"backup,omitempty"`     // OpDeploy, OpLazyVim; empty if nothing existed before
+	Key       string `json:"key,omitempty"`        // OpGitConfig
+	Value     string `json:"value,omitempty"`      // OpGitConfig
+}
+
+// Run is the journal for one invocation of winsetup: every change it made,
+// in the order it made them.
+type Run struct {
+	ID         string      `json:"id"`
+	StartedAt  time.Time   `json:"started_at"`
+	Operations []Operation `json:"operations"`
+}
+
+// Current is the run in progress, if any. Record is a no-op when this is
+// nil, the same way the install/deploy helpers already gate on ui.DryRun.
+var Current *Run
+
+func dir() string {
+	return filepath.Join(os.Getenv("LOCALAPPDATA"), "windows-dev-setup", "history")
+}
+
+func runPath(id string) string {
+	return filepath.Join(dir(), id+".json")
+}
+
+// StartRun begins a new run and makes it Current, so every Record call
+// from here on is appended to its file as it happens.
+func StartRun() *Run {
+	r := &Run{ID: time.Now().Format("20060102-150405"), StartedAt: time.Now()}
+	Current = r
+	return r
+}
+
+// Record appends op to the current run and saves it immediately, if a run
+// is in progress. It's a no-op otherwise (e.g. during `winsetup verify`,
+// which never starts one).
+func Record(op Operation) {
+	if Current == nil {
+		return
+	}
+	Current.Operations = append(Current.Operations, op)
+	Current.save()
+}
+
+func (r *Run) save() error {
+	if err := os.MkdirAll(dir(), 0755); err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(runPath(r.ID), b, 0644)
+}
+
+// List returns every recorded run ID, oldest first.
+func List() ([]string, error) {
+	entries, err := os.ReadDir(dir())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".json" {
+			continue
+		}
+		ids = append(ids, e.Name()[:len(e.Name())-len(".json")])
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// Load reads back a previously recorded run by ID.
+func Load(id string) (*Run, error) {
+	b, err := os.ReadFile(runPath(id))
+	if err != nil {
+		return nil, err
+	}
+
+	var r Run
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// Remove deletes a run's file, e.g. after a successful rollback.
+func Remove(id string) error {
+	err := os.Remove(runPath(id))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,153 @@
+//go:build windows
+
+// Package manifest defines the declarative setup manifest format (a DAG of
+// install steps plus git config entries) and loads/orders it. It has no
+// knowledge of how a step is actually executed - that lives in internal/runner.
+package manifest
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+
+	"github.com/BurntSushi/toml"
+)
+
+//go:embed setup.default.toml
+var DefaultTOML string
+
+// ConfigDeploy is a single source/target pair to copy into place once its
+// owning step has been installed.
+type ConfigDeploy struct {
+	Source string `toml:"source"`
+	Target string `toml:"target"`
+}
+
+// BackendCandidate is one entry in a step's fallback chain: "try winget,
+// then scoop, then a direct MSI" is three of these in priority order.
+type BackendCandidate struct {
+	Backend   string `toml:"backend"` // winget, scoop, choco, direct
+	PackageID string `toml:"package_id"`
+	Bucket    string `toml:"bucket"`
+	URL       string `toml:"url"`    // direct backend
+	SHA256    string `toml:"sha256"` // direct backend
+}
+
+// Step describes one entry in the setup manifest: a tool to install via a
+// package-manager backend, its place in the dependency DAG, the command
+// run to populate the post-install verification table, and any config
+// files that should be deployed once it's in place.
+//
+// Most steps declare a single Backend/PackageID pair. A step that should
+// be attempted through several package managers in priority order - e.g.
+// winget, falling back to scoop, falling back to a direct MSI - declares
+// Backends instead; when present it takes precedence over Backend.
+type Step struct {
+	Name       string             `toml:"name"`
+	Backend    string             `toml:"backend"` // winget, scoop, powershell, direct-url, plugin, noop
+	PackageID  string             `toml:"package_id"`
+	Bucket     string             `toml:"bucket"`
+	Version    string             `toml:"version"`
+	Command    string             `toml:"command"` // used by the powershell/direct-url/plugin backends
+	Kind       string             `toml:"kind"`    // special-cases a powershell step: "scoop-bootstrap", "lazyvim-clone"
+	Backends   []BackendCandidate `toml:"backends"`
+	DependsOn  []string           `toml:"depends_on"`
+	VerifyCmd  string             `toml:"verify_cmd"`
+	VerifyArgs string             `toml:"verify_args"`
+	Deploys    []ConfigDeploy     `toml:"deploy"`
+
+	// Executable and PluginRoot are never set from TOML; internal/plugin
+	// populates them when it merges a plugin's steps into the manifest DAG.
+	// Executable is the plugin backend's alternative to Command: a binary
+	// to invoke instead of a powershell snippet. PluginRoot is the owning
+	// plugin's directory, used in place of scriptRoot to resolve its
+	// deploys and as the SCRIPT_ROOT env var passed to Command/Executable.
+	Executable string `toml:"-"`
+	PluginRoot string `toml:"-"`
+}
+
+// GitConfigEntry is one `git config --global` key the manifest wants set.
+// If Prompt is non-empty and the key isn't already configured, the user is
+// asked for a value instead of using Value directly.
+type GitConfigEntry struct {
+	Key    string `toml:"key"`
+	Value  string `toml:"value"`
+	Prompt string `toml:"prompt"`
+}
+
+// Manifest is the full declarative description of a setup run.
+type Manifest struct {
+	Steps      []Step           `toml:"step"`
+	GitConfigs []GitConfigEntry `toml:"git_config"`
+}
+
+// Load reads the manifest at path, or falls back to the embedded default
+// (the historical 13-step sequence) when path is empty.
+func Load(path string) (*Manifest, error) {
+	var raw string
+	if path == "" {
+		raw = DefaultTOML
+	} else {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading manifest %s: %w", path, err)
+		}
+		raw = string(b)
+	}
+
+	var m Manifest
+	if _, err := toml.Decode(raw, &m); err != nil {
+		return nil, fmt.Errorf("parsing manifest: %w", err)
+	}
+	return &m, nil
+}
+
+// Order topologically sorts steps by their depends_on lists so that every
+// step runs after everything it depends on. It returns an error on an
+// unknown dependency name or a cycle.
+func Order(steps []Step) ([]Step, error) {
+	byName := make(map[string]Step, len(steps))
+	for _, s := range steps {
+		byName[s.Name] = s
+	}
+
+	var (
+		ordered []Step
+		visited = make(map[string]int) // 0=unvisited, 1=in-progress, 2=done
+	)
+
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch visited[name] {
+		case 2:
+			return nil
+		case 1:
+			return fmt.Errorf("dependency cycle detected at step %q", name)
+		}
+
+		step, ok := byName[name]
+		if !ok {
+			return fmt.Errorf("step %q depends on unknown step %q", name, name)
+		}
+
+		visited[name] = 1
+		for _, dep := range step.DependsOn {
+			if _, ok := byName[dep]; !ok {
+				return fmt.Errorf("step %q depends on unknown step %q", name, dep)
+			}
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		visited[name] = 2
+		ordered = append(ordered, step)
+		return nil
+	}
+
+	for _, s := range steps {
+		if err := visit(s.Name); err != nil {
+			return nil, err
+		}
+	}
+	return ordered, nil
+}
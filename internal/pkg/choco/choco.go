@@ -0,0 +1,52 @@
+//go:build windows
+
+// Package choco installs packages through Chocolatey.
+package choco
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MuiGoku123432/windows-dev-setup/internal/history"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/ui"
+)
+
+// IsInstalled reports whether pkg already appears in `choco list --local-only`.
+func IsInstalled(pkg string) bool {
+	out, _ := ui.RunCmd("choco", "list", "--local-only", pkg)
+	return strings.Contains(strings.ToLower(out), strings.ToLower(pkg))
+}
+
+// Bootstrap installs Chocolatey itself if the `choco` command isn't on PATH.
+func Bootstrap() {
+	if ui.CommandExists("choco") {
+		ui.Skip("Chocolatey already installed")
+		return
+	}
+
+	fmt.Printf("   %sInstalling Chocolatey...%s\n", ui.ColorWhite, ui.ColorReset)
+	err := ui.RunCmdPassthrough("powershell", "-NoProfile", "-Command",
+		"Set-ExecutionPolicy Bypass -Scope Process -Force; "+
+			"[System.Net.ServicePointManager]::SecurityProtocol = [System.Net.ServicePointManager]::SecurityProtocol -bor 3072; "+
+			"iex ((New-Object System.Net.WebClient).DownloadString('https://community.chocolatey.org/install.ps1'))")
+	if err != nil {
+		ui.Fail("Failed to install Chocolatey: " + err.Error())
+		return
+	}
+
+	ui.RefreshPath()
+	ui.Success("Chocolatey installed")
+	history.Record(history.Operation{Type: history.OpPackage, Name: "choco", Backend: "choco-bootstrap", PackageID: "choco"})
+}
+
+// InstallSilent installs pkg via choco with no console output of its own,
+// for callers (like internal/backend) that do their own reporting.
+func InstallSilent(pkg string) error {
+	return ui.RunCmdPassthrough("choco", "install", pkg, "-y", "--no-progress")
+}
+
+// Uninstall removes pkg via choco. Used by rollback to undo a package
+// this tool installed.
+func Uninstall(pkg string) error {
+	return ui.RunCmdPassthrough("choco", "uninstall", pkg, "-y")
+}
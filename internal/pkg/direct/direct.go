@@ -0,0 +1,69 @@
+//go:build windows
+
+// Package direct installs tools that aren't in winget/scoop/choco by
+// downloading an installer URL, verifying its digest, and running it
+// silently.
+package direct
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/MuiGoku123432/windows-dev-setup/internal/deploy"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/ui"
+)
+
+// Install downloads url to a temp file, verifies it against sha256Digest
+// (when non-empty), and runs it silently.
+func Install(url, sha256Digest string) error {
+	installerPath, err := download(url)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(installerPath)
+
+	if sha256Digest != "" {
+		actual, err := deploy.FileHash(installerPath)
+		if err != nil {
+			return fmt.Errorf("hashing downloaded installer: %w", err)
+		}
+		if !strings.EqualFold(actual, sha256Digest) {
+			return fmt.Errorf("SHA-256 mismatch: expected %s, got %s", sha256Digest, actual)
+		}
+	}
+
+	return runSilently(installerPath)
+}
+
+func download(url string) (string, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return "", fmt.Errorf("downloading %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	f, err := os.CreateTemp("", "winsetup-*"+filepath.Ext(url))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, resp.Body); err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+func runSilently(installerPath string) error {
+	switch strings.ToLower(filepath.Ext(installerPath)) {
+	case ".msi":
+		return ui.RunCmdPassthrough("msiexec", "/i", installerPath, "/quiet", "/norestart")
+	default:
+		return ui.RunCmdPassthrough(installerPath, "/S")
+	}
+}
@@ -0,0 +1,83 @@
+//go:build windows
+
+// Package scoop installs packages through the Scoop package manager,
+// including bootstrapping Scoop itself.
+package scoop
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MuiGoku123432/windows-dev-setup/internal/history"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/ui"
+)
+
+// IsInstalled reports whether pkg already appears in `scoop list`.
+func IsInstalled(pkg string) bool {
+	out, _ := ui.RunCmd("scoop", "list")
+	return strings.Contains(out, pkg)
+}
+
+// Bootstrap installs Scoop itself if the `scoop` command isn't on PATH.
+func Bootstrap() {
+	if ui.CommandExists("scoop") {
+		ui.Skip("Scoop already installed")
+		return
+	}
+
+	fmt.Printf("   %sInstalling Scoop...%s\n", ui.ColorWhite, ui.ColorReset)
+	err := ui.RunCmdPassthrough("powershell", "-NoProfile", "-Command",
+		"Invoke-RestMethod get.scoop.sh | Invoke-Expression")
+	if err != nil {
+		ui.Fail("Failed to install Scoop: " + err.Error())
+		return
+	}
+
+	ui.RefreshPath()
+	ui.Success("Scoop installed")
+	history.Record(history.Operation{Type: history.OpPackage, Name: "scoop", Backend: "scoop-bootstrap", PackageID: "scoop"})
+}
+
+// InstallSilent installs pkg via scoop (adding bucket first if needed)
+// with no console output of its own, for callers (like internal/backend)
+// that do their own reporting. version pins an exact release via scoop's
+// `pkg@version` syntax when non-empty.
+func InstallSilent(pkg, bucket, version string) error {
+	if bucket != "" {
+		bucketOut, _ := ui.RunCmd("scoop", "bucket", "list")
+		if !strings.Contains(bucketOut, bucket) {
+			ui.RunCmd("scoop", "bucket", "add", bucket)
+		}
+	}
+	target := pkg
+	if version != "" {
+		target = pkg + "@" + version
+	}
+	return ui.RunCmdPassthrough("scoop", "install", target)
+}
+
+// Install installs pkg via scoop, adding bucket first if it isn't already
+// registered and bucket is non-empty. version pins an exact release when
+// non-empty.
+func Install(pkg, bucket, version string) {
+	if IsInstalled(pkg) {
+		ui.Skip(pkg + " already installed (scoop)")
+		return
+	}
+
+	fmt.Printf("   %sInstalling %s via scoop...%s\n", ui.ColorWhite, pkg, ui.ColorReset)
+	if err := InstallSilent(pkg, bucket, version); err != nil {
+		ui.Fail("Failed to install " + pkg + " via scoop")
+		return
+	}
+
+	ui.RefreshPath()
+	ui.Success(pkg + " installed (scoop)")
+	history.Record(history.Operation{Type: history.OpPackage, Name: pkg, Backend: "scoop", PackageID: pkg})
+}
+
+// Uninstall removes pkg via scoop. Used by rollback to undo a package
+// this tool installed.
+func Uninstall(pkg string) error {
+	return ui.RunCmdPassthrough("scoop", "uninstall", pkg)
+}
@@ -0,0 +1,120 @@
+//go:build windows
+
+// Package tui provides the arrow-key selection menus used by
+// `winsetup --interactive`, built on manifoldco/promptui.
+package tui
+
+import (
+	"fmt"
+
+	"github.com/MuiGoku123432/windows-dev-setup/internal/manifest"
+	"github.com/manifoldco/promptui"
+)
+
+// Prompt asks for a line of free-form text, with optional validation and
+// masked input (for anything sensitive enough to not echo to the screen).
+// It replaces the old bufio-based promptInput helper.
+func Prompt(label string, validate promptui.ValidateFunc, mask bool) (string, error) {
+	p := promptui.Prompt{Label: label, Validate: validate}
+	if mask {
+		p.Mask = '*'
+	}
+	return p.Run()
+}
+
+// Confirm asks a yes/no question and reports whether the user answered
+// yes. A non-y/n response (including Ctrl-C) is treated as no.
+func Confirm(label string) bool {
+	p := promptui.Prompt{Label: label, IsConfirm: true}
+	_, err := p.Run()
+	return err == nil
+}
+
+// SelectSteps presents a multi-select checklist of manifest steps and
+// returns the ones the user checked, in their original manifest order.
+func SelectSteps(steps []manifest.Step) ([]manifest.Step, error) {
+	selected, err := multiSelect("Select tools to install", len(steps), func(i int) string {
+		return steps[i].Name
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var out []manifest.Step
+	for _, i := range selected {
+		out = append(out, steps[i])
+	}
+	return out, nil
+}
+
+// DeployChoice is one config file a user can choose to deploy, labeled
+// with the step it belongs to.
+type DeployChoice struct {
+	StepName string
+	Deploy   manifest.ConfigDeploy
+}
+
+// SelectDeploys presents a multi-select checklist of every config file
+// deployment declared across steps and returns the ones checked.
+func SelectDeploys(steps []manifest.Step) ([]DeployChoice, error) {
+	var choices []DeployChoice
+	for _, step := range steps {
+		for _, d := range step.Deploys {
+			choices = append(choices, DeployChoice{StepName: step.Name, Deploy: d})
+		}
+	}
+	if len(choices) == 0 {
+		return nil, nil
+	}
+
+	selected, err := multiSelect("Select config files to deploy", len(choices), func(i int) string {
+		return fmt.Sprintf("%s (%s)", choices[i].Deploy.Target, choices[i].StepName)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var out []DeployChoice
+	for _, i := range selected {
+		out = append(out, choices[i])
+	}
+	return out, nil
+}
+
+// multiSelect renders a repeating promptui.Select checklist: choosing an
+// item toggles it, choosing "Done" returns every checked index. promptui
+// has no native multi-select, so this is the standard way to build one on
+// top of its single-select.
+func multiSelect(label string, n int, itemLabel func(i int) string) ([]int, error) {
+	checked := make([]bool, n)
+
+	for {
+		items := make([]string, 0, n+1)
+		for i := 0; i < n; i++ {
+			mark := "[ ]"
+			if checked[i] {
+				mark = "[x]"
+			}
+			items = append(items, fmt.Sprintf("%s %s", mark, itemLabel(i)))
+		}
+		items = append(items, "[Done]")
+
+		sel := promptui.Select{Label: label, Items: items, Size: n + 1}
+		idx, _, err := sel.Run()
+		if err != nil {
+			return nil, err
+		}
+		if idx == n {
+			break
+		}
+		checked[idx] = !checked[idx]
+	}
+
+	var selected []int
+	for i, c := range checked {
+		if c {
+			selected = append(selected, i)
+		}
+	}
+	return selected, nil
+}
@@ -0,0 +1,57 @@
+//go:build windows
+
+// Package winget installs packages through the winget package manager.
+package winget
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MuiGoku123432/windows-dev-setup/internal/history"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/ui"
+)
+
+// IsInstalled reports whether packageID already appears in `winget list`.
+func IsInstalled(packageID string) bool {
+	out, _ := ui.RunCmd("winget", "list", "--id", packageID, "--accept-source-agreements")
+	return strings.Contains(out, packageID)
+}
+
+// InstallSilent installs packageID via winget with no console output of
+// its own, for callers (like internal/backend) that do their own
+// reporting. version pins an exact release when non-empty, otherwise
+// winget installs whatever it considers latest.
+func InstallSilent(packageID, version string) error {
+	args := []string{"install", "--id", packageID, "--exact",
+		"--accept-source-agreements", "--accept-package-agreements", "--silent"}
+	if version != "" {
+		args = append(args, "--version", version)
+	}
+	return ui.RunCmdPassthrough("winget", args...)
+}
+
+// Install installs packageID via winget if it isn't already present,
+// refreshing PATH afterwards so later steps can see newly installed tools.
+// version pins an exact release when non-empty.
+func Install(packageID, displayName, version string) {
+	if IsInstalled(packageID) {
+		ui.Skip(displayName + " already installed")
+		return
+	}
+
+	fmt.Printf("   %sInstalling %s...%s\n", ui.ColorWhite, displayName, ui.ColorReset)
+	if err := InstallSilent(packageID, version); err != nil {
+		ui.Fail(fmt.Sprintf("Failed to install %s (%s)", displayName, packageID))
+		return
+	}
+
+	ui.RefreshPath()
+	ui.Success(displayName + " installed")
+	history.Record(history.Operation{Type: history.OpPackage, Name: displayName, Backend: "winget", PackageID: packageID})
+}
+
+// Uninstall removes packageID via winget. Used by rollback to undo a
+// package this tool installed.
+func Uninstall(packageID string) error {
+	return ui.RunCmdPassthrough("winget", "uninstall", "--id", packageID, "--exact", "--silent")
+}
@@ -0,0 +1,127 @@
+//go:build windows
+
+// Package plugin discovers third-party install recipes dropped into
+// %LOCALAPPDATA%\windows-dev-setup\plugins, Helm-style: each plugin is its
+// own directory with a plugin.yaml describing steps that get merged into
+// the main manifest's dependency DAG and run through internal/runner like
+// any other step.
+package plugin
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/MuiGoku123432/windows-dev-setup/internal/manifest"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/ui"
+	"gopkg.in/yaml.v3"
+)
+
+// Dir returns the plugins directory. It isn't created here; Discover just
+// returns nothing if it doesn't exist, and `plugin install` creates it.
+func Dir() string {
+	return filepath.Join(os.Getenv("LOCALAPPDATA"), "windows-dev-setup", "plugins")
+}
+
+// Step is one install step or config deploy declared by a plugin. It
+// mirrors the manifest.Step fields that make sense for a third-party
+// recipe, plus Executable for the "run a binary" half of the plugin
+// contract (Command covers the inline-powershell half).
+type Step struct {
+	Name       string                  `yaml:"name"`
+	Backend    string                  `yaml:"backend"`
+	PackageID  string                  `yaml:"package_id"`
+	Bucket     string                  `yaml:"bucket"`
+	Command    string                  `yaml:"command"`
+	Executable string                  `yaml:"executable"`
+	DependsOn  []string                `yaml:"depends_on"`
+	VerifyCmd  string                  `yaml:"verify_cmd"`
+	VerifyArgs string                  `yaml:"verify_args"`
+	Deploys    []manifest.ConfigDeploy `yaml:"deploy"`
+}
+
+// Manifest is the plugin.yaml schema: a name, version, dependencies (other
+// plugin names or required tools), and the steps it contributes.
+type Manifest struct {
+	Name      string   `yaml:"name"`
+	Version   string   `yaml:"version"`
+	DependsOn []string `yaml:"depends_on"`
+	Steps     []Step   `yaml:"step"`
+}
+
+// Plugin is a discovered plugin: its manifest plus the directory it was
+// loaded from, which doubles as its SCRIPT_ROOT.
+type Plugin struct {
+	Manifest
+	Root string
+}
+
+// Discover scans the plugins directory for */plugin.yaml and parses each
+// one. A plugin that fails to parse is reported and skipped rather than
+// failing the whole run.
+func Discover() []Plugin {
+	entries, err := os.ReadDir(Dir())
+	if err != nil {
+		return nil
+	}
+
+	var plugins []Plugin
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		pluginDir := filepath.Join(Dir(), e.Name())
+		data, err := os.ReadFile(filepath.Join(pluginDir, "plugin.yaml"))
+		if err != nil {
+			continue
+		}
+
+		var m Manifest
+		if err := yaml.Unmarshal(data, &m); err != nil {
+			ui.Fail(fmt.Sprintf("plugin %s: invalid plugin.yaml: %s", e.Name(), err))
+			continue
+		}
+		if m.Name == "" {
+			m.Name = e.Name()
+		}
+		plugins = append(plugins, Plugin{Manifest: m, Root: pluginDir})
+	}
+	return plugins
+}
+
+// Merge folds every discovered plugin's steps into steps, namespacing each
+// plugin's own step names with "<plugin>/" and adding a completion marker
+// per plugin so other steps can depend on the plugin as a whole by name.
+func Merge(steps []manifest.Step, plugins []Plugin) []manifest.Step {
+	out := append([]manifest.Step{}, steps...)
+
+	for _, p := range plugins {
+		var ownStepNames []string
+		for _, s := range p.Steps {
+			qualified := p.Name + "/" + s.Name
+
+			dependsOn := append([]string{}, p.DependsOn...)
+			dependsOn = append(dependsOn, s.DependsOn...)
+
+			out = append(out, manifest.Step{
+				Name:       qualified,
+				Backend:    s.Backend,
+				PackageID:  s.PackageID,
+				Bucket:     s.Bucket,
+				Command:    s.Command,
+				Executable: s.Executable,
+				DependsOn:  dependsOn,
+				VerifyCmd:  s.VerifyCmd,
+				VerifyArgs: s.VerifyArgs,
+				Deploys:    s.Deploys,
+				PluginRoot: p.Root,
+			})
+			ownStepNames = append(ownStepNames, qualified)
+		}
+
+		// Completion marker: lets another plugin's DependsOn reference this
+		// plugin by its bare name instead of every one of its step names.
+		out = append(out, manifest.Step{Name: p.Name, Backend: "noop", DependsOn: ownStepNames})
+	}
+	return out
+}
@@ -0,0 +1,57 @@
+//go:build windows
+
+package plugin
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/MuiGoku123432/windows-dev-setup/internal/manifest"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/ui"
+)
+
+// Run executes a plugin step's inline Command or Executable under the
+// plugin contract: SCRIPT_ROOT (the plugin's own directory) and
+// LOCALAPPDATA set as env vars, output captured rather than streamed and
+// folded into ui.Success/ui.Fail instead of passing through to the
+// console directly.
+func Run(step manifest.Step) {
+	if step.VerifyCmd != "" && ui.CommandExists(step.VerifyCmd) {
+		ui.Skip(step.Name + " already installed")
+		return
+	}
+
+	var cmd *exec.Cmd
+	if step.Executable != "" {
+		cmd = exec.Command(filepath.Join(step.PluginRoot, step.Executable))
+	} else {
+		cmd = exec.Command("powershell", "-NoProfile", "-Command", step.Command)
+	}
+	cmd.Env = append(os.Environ(),
+		"SCRIPT_ROOT="+step.PluginRoot,
+		"LOCALAPPDATA="+os.Getenv("LOCALAPPDATA"),
+	)
+
+	fmt.Printf("   %sRunning %s...%s\n", ui.ColorWhite, step.Name, ui.ColorReset)
+	out, err := cmd.CombinedOutput()
+	output := strings.TrimSpace(string(out))
+	if err != nil {
+		msg := step.Name + " failed"
+		if output != "" {
+			msg += ": " + output
+		} else {
+			msg += ": " + err.Error()
+		}
+		ui.Fail(msg)
+		return
+	}
+
+	if output != "" {
+		fmt.Println("   " + output)
+	}
+	ui.RefreshPath()
+	ui.Success(step.Name + " installed")
+}
@@ -0,0 +1,272 @@
+//go:build windows
+
+// Package runner executes a manifest: installing steps through their
+// declared backend, deploying the config files attached to them, and
+// applying git config entries. This is the glue between internal/manifest
+// (what to do) and the backend subpackages (how to do it).
+package runner
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/MuiGoku123432/windows-dev-setup/internal/backend"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/deploy"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/gitcfg"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/history"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/manifest"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/pkg/scoop"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/pkg/tui"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/pkg/winget"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/plugin"
+	"github.com/MuiGoku123432/windows-dev-setup/internal/ui"
+)
+
+// ExpandPathVars substitutes the environment variables config deploy
+// targets are declared in terms of.
+func ExpandPathVars(path string) string {
+	replacer := strings.NewReplacer(
+		"$USERPROFILE", os.Getenv("USERPROFILE"),
+		"$APPDATA", os.Getenv("APPDATA"),
+		"$LOCALAPPDATA", os.Getenv("LOCALAPPDATA"),
+	)
+	return filepath.Clean(replacer.Replace(path))
+}
+
+// InstallStep installs one manifest step through its declared backend,
+// without touching the config files attached to it. Under --dry-run it
+// only reports what it would install.
+func InstallStep(step manifest.Step) {
+	if ui.DryRun {
+		previewInstall(step)
+		return
+	}
+
+	switch {
+	case len(step.Backends) > 0:
+		backend.InstallCandidates(step.Backends, step.Name, step.VerifyCmd)
+	case step.Backend == "winget":
+		winget.Install(step.PackageID, step.Name, step.Version)
+	case step.Backend == "scoop":
+		scoop.Install(step.PackageID, step.Bucket, step.Version)
+	case step.Backend == "powershell", step.Backend == "direct-url":
+		runPowershellStep(step)
+	case step.Backend == "plugin":
+		plugin.Run(step)
+	case step.Backend == "noop":
+		// A plugin completion marker: nothing to install, it exists only
+		// so other steps can depend on the plugin by name.
+	default:
+		ui.Fail(fmt.Sprintf("%s: unknown backend %q", step.Name, step.Backend))
+	}
+}
+
+// DeployStep deploys every config file attached to step. scriptRoot is
+// resolved against each deploy's Source, except for a step that came from
+// a plugin, whose deploys are resolved against its own PluginRoot instead.
+func DeployStep(scriptRoot string, step manifest.Step) {
+	root := scriptRoot
+	if step.PluginRoot != "" {
+		root = step.PluginRoot
+	}
+	for _, d := range step.Deploys {
+		deploy.File(filepath.Join(root, d.Source), ExpandPathVars(d.Target))
+	}
+}
+
+// RunStep installs one manifest step through its declared backend, then
+// deploys any config files attached to it. scriptRoot is resolved against
+// each deploy's Source.
+func RunStep(scriptRoot string, step manifest.Step) {
+	InstallStep(step)
+	DeployStep(scriptRoot, step)
+}
+
+// previewInstall reports what RunStep would install for step, without
+// running anything, for --dry-run.
+func previewInstall(step manifest.Step) {
+	switch {
+	case len(step.Backends) > 0:
+		if step.VerifyCmd != "" && ui.CommandExists(step.VerifyCmd) {
+			ui.Skip(step.Name + " already installed")
+			return
+		}
+		for _, c := range step.Backends {
+			b, ok := backend.Get(c.Backend)
+			if !ok || !b.IsAvailable() {
+				continue
+			}
+			if b.IsInstalled(c.PackageID) {
+				ui.Skip(step.Name + " already installed (" + b.Name() + ")")
+			} else {
+				ui.Preview(fmt.Sprintf("Would install %s via %s", step.Name, b.Name()))
+			}
+			return
+		}
+		ui.Preview(step.Name + ": no candidate backend is available")
+	case step.Backend == "winget":
+		if winget.IsInstalled(step.PackageID) {
+			ui.Skip(step.Name + " already installed")
+		} else {
+			ui.Preview("Would install " + step.Name + " via winget")
+		}
+	case step.Backend == "scoop":
+		if scoop.IsInstalled(step.PackageID) {
+			ui.Skip(step.Name + " already installed (scoop)")
+		} else {
+			ui.Preview("Would install " + step.Name + " via scoop")
+		}
+	case step.Backend == "powershell", step.Backend == "direct-url":
+		if step.VerifyCmd != "" && ui.CommandExists(step.VerifyCmd) {
+			ui.Skip(step.Name + " already installed")
+		} else {
+			ui.Preview("Would run: " + step.Command)
+		}
+	case step.Backend == "plugin":
+		if step.VerifyCmd != "" && ui.CommandExists(step.VerifyCmd) {
+			ui.Skip(step.Name + " already installed")
+		} else {
+			ui.Preview("Would run plugin step: " + step.Name)
+		}
+	case step.Backend == "noop":
+		// Completion marker; nothing to preview.
+	default:
+		ui.Preview(fmt.Sprintf("%s: unknown backend %q", step.Name, step.Backend))
+	}
+}
+
+// RunSteps runs every step in steps, in the order given, under the
+// "Step i/n: name" banner the full flow has always printed.
+func RunSteps(scriptRoot string, steps []manifest.Step) {
+	for i, step := range steps {
+		ui.Step(fmt.Sprintf("Step %d/%d: %s", i+1, len(steps), step.Name))
+		RunStep(scriptRoot, step)
+	}
+}
+
+// runPowershellStep covers the steps that don't map onto a plain package
+// install: bootstrapping Scoop itself, installing Node via Volta, and
+// cloning the LazyVim starter. The Scoop and LazyVim steps are identified
+// by the manifest's own step.Kind, not by name, so a custom profile can rename
+// or reorder them and still get the right backup/rollback behavior.
+func runPowershellStep(step manifest.Step) {
+	switch step.Kind {
+	case "scoop-bootstrap":
+		scoop.Bootstrap()
+		return
+	case "lazyvim-clone":
+		runLazyVimStep(step)
+		return
+	}
+
+	if step.VerifyCmd != "" && ui.CommandExists(step.VerifyCmd) {
+		ui.Skip(step.Name + " already installed")
+		return
+	}
+
+	fmt.Printf("   %sInstalling %s...%s\n", ui.ColorWhite, step.Name, ui.ColorReset)
+	if err := ui.RunCmdPassthrough("powershell", "-NoProfile", "-Command", step.Command); err != nil {
+		ui.Fail("Failed to install " + step.Name + ": " + err.Error())
+		return
+	}
+
+	ui.RefreshPath()
+	ui.Success(step.Name + " installed")
+	history.Record(history.Operation{Type: history.OpPackage, Name: step.Name, Backend: "powershell", PackageID: step.Command})
+}
+
+// runLazyVimStep reproduces the historical LazyVim bootstrap: skip if
+// already configured, back up any existing nvim config dir, then clone.
+func runLazyVimStep(step manifest.Step) {
+	nvimConfigDir := filepath.Join(os.Getenv("LOCALAPPDATA"), "nvim")
+	lazyVimMarker := filepath.Join(nvimConfigDir, "lua", "config", "lazy.lua")
+
+	if _, err := os.Stat(lazyVimMarker); err == nil {
+		ui.Skip("LazyVim already configured")
+		return
+	}
+
+	if !ui.CommandExists("git") {
+		ui.Fail("Git not found - cannot clone LazyVim starter")
+		return
+	}
+
+	var backupDir string
+	if _, err := os.Stat(nvimConfigDir); err == nil {
+		timestamp := time.Now().Format("20060102-150405")
+		backupDir = nvimConfigDir + ".bak." + timestamp
+		fmt.Printf("   %sBacking up existing nvim config to %s%s\n", ui.ColorYellow, backupDir, ui.ColorReset)
+		os.Rename(nvimConfigDir, backupDir)
+	}
+
+	fmt.Printf("   %sCloning LazyVim starter...%s\n", ui.ColorWhite, ui.ColorReset)
+	if err := ui.RunCmdPassthrough("powershell", "-NoProfile", "-Command", step.Command); err != nil {
+		ui.Fail("Failed to clone LazyVim starter")
+		return
+	}
+
+	gitDir := filepath.Join(nvimConfigDir, ".git")
+	os.RemoveAll(gitDir)
+	ui.Success("LazyVim starter cloned to " + nvimConfigDir)
+	history.Record(history.Operation{Type: history.OpLazyVim, Name: "lazyvim", Target: nvimConfigDir, Backup: backupDir})
+}
+
+// ApplyGitConfig sets every manifest git config entry, prompting for a
+// value when the manifest declares a Prompt and the key isn't already set.
+func ApplyGitConfig(entries []manifest.GitConfigEntry) {
+	if !ui.CommandExists("git") {
+		ui.Fail("Git not found - skipping git config")
+		return
+	}
+
+	for _, e := range entries {
+		if e.Prompt == "" {
+			gitcfg.SetIfMissing(e.Key, e.Value)
+			continue
+		}
+
+		if current := gitcfg.Get(e.Key); current != "" {
+			ui.Skip(fmt.Sprintf("git %s already set to '%s'", e.Key, current))
+			continue
+		}
+
+		fmt.Println()
+		value, err := tui.Prompt(e.Prompt, nil, false)
+		if err != nil || value == "" {
+			continue
+		}
+		ui.RunCmd("git", "config", "--global", e.Key, value)
+		ui.Success(fmt.Sprintf("git %s set to '%s'", e.Key, value))
+		history.Record(history.Operation{Type: history.OpGitConfig, Name: e.Key, Key: e.Key, Value: value})
+	}
+}
+
+// VerifyTable prints the version of every step that declares a verify
+// command, matching the table the `all` flow has always ended with.
+func VerifyTable(steps []manifest.Step) {
+	ui.RefreshPath()
+
+	fmt.Println()
+	fmt.Printf("   %sTool            Version%s\n", ui.ColorWhite, ui.ColorReset)
+	fmt.Printf("   %s────            ───────%s\n", ui.ColorWhite, ui.ColorReset)
+
+	for _, step := range steps {
+		if step.VerifyCmd == "" {
+			continue
+		}
+		if ui.CommandExists(step.VerifyCmd) {
+			out, _ := ui.RunCmd(step.VerifyCmd, step.VerifyArgs)
+			version := out
+			if idx := strings.IndexByte(version, '\n'); idx != -1 {
+				version = version[:idx]
+			}
+			version = ui.ExtractVersion(version)
+			fmt.Printf("   %s%-16s%s%s\n", ui.ColorGreen, step.Name, version, ui.ColorReset)
+		} else {
+			fmt.Printf("   %s%-16s%s%s\n", ui.ColorRed, step.Name, "NOT FOUND", ui.ColorReset)
+		}
+	}
+}
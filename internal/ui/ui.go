@@ -0,0 +1,137 @@
+//go:build windows
+
+// Package ui holds the console output helpers and shared process-execution
+// primitives used by every install/deploy/verify subpackage: the ANSI
+// color constants, the Step/Success/Skip/Fail family, and the failure
+// list they accumulate into for the final run summary.
+package ui
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"syscall"
+	"unsafe"
+)
+
+// ─── ANSI Colors ────────────────────────────────────────────────────
+
+const (
+	ColorReset   = "\033[0m"
+	ColorRed     = "\033[31m"
+	ColorGreen   = "\033[32m"
+	ColorYellow  = "\033[33m"
+	ColorCyan    = "\033[36m"
+	ColorMagenta = "\033[35m"
+	ColorWhite   = "\033[37m"
+)
+
+var failures []string
+
+// DryRun is set by --dry-run. Install/deploy helpers consult it to decide
+// whether to actually touch the system or just report what they would do.
+var DryRun bool
+
+// Failures returns every message passed to Fail so far, in order.
+func Failures() []string {
+	return failures
+}
+
+// ResetFailures clears the accumulated failure list. Subcommands that run
+// independently of the full `all` flow call this before they start.
+func ResetFailures() {
+	failures = nil
+}
+
+func Step(msg string) {
+	fmt.Printf("\n%s:: %s%s\n", ColorCyan, msg, ColorReset)
+}
+
+func Success(msg string) {
+	fmt.Printf("   %s[OK]%s %s\n", ColorGreen, ColorReset, msg)
+}
+
+func Skip(msg string) {
+	fmt.Printf("   %s[SKIP]%s %s\n", ColorYellow, ColorReset, msg)
+}
+
+// Preview reports what a --dry-run would have done, without touching
+// failures (a dry run can't fail).
+func Preview(msg string) {
+	fmt.Printf("   %s[DRY]%s %s\n", ColorCyan, ColorReset, msg)
+}
+
+func Fail(msg string) {
+	fmt.Printf("   %s[FAIL]%s %s\n", ColorRed, ColorReset, msg)
+	failures = append(failures, msg)
+}
+
+// ─── Windows Console Setup ──────────────────────────────────────────
+
+// EnableVirtualTerminal turns on ANSI escape sequence processing for the
+// current console so the color constants above actually render.
+func EnableVirtualTerminal() {
+	kernel32 := syscall.NewLazyDLL("kernel32.dll")
+	getStdHandle := kernel32.NewProc("GetStdHandle")
+	getConsoleMode := kernel32.NewProc("GetConsoleMode")
+	setConsoleMode := kernel32.NewProc("SetConsoleMode")
+
+	const stdOutputHandle = ^uintptr(0) - 11 + 1 // STD_OUTPUT_HANDLE = -11
+	const enableVirtualTerminalProcessing = 0x0004
+
+	handle, _, _ := getStdHandle.Call(stdOutputHandle)
+	var mode uint32
+	getConsoleMode.Call(handle, uintptr(unsafe.Pointer(&mode)))
+	setConsoleMode.Call(handle, uintptr(mode|enableVirtualTerminalProcessing))
+}
+
+// ─── Process Helpers ─────────────────────────────────────────────────
+
+func RefreshPath() {
+	out, err := exec.Command("powershell", "-NoProfile", "-Command",
+		`[Environment]::GetEnvironmentVariable('Path','Machine') + ';' + [Environment]::GetEnvironmentVariable('Path','User')`).Output()
+	if err == nil {
+		os.Setenv("PATH", strings.TrimSpace(string(out)))
+	}
+}
+
+func CommandExists(name string) bool {
+	_, err := exec.LookPath(name)
+	return err == nil
+}
+
+func RunCmd(name string, args ...string) (string, error) {
+	cmd := exec.Command(name, args...)
+	out, err := cmd.CombinedOutput()
+	return strings.TrimSpace(string(out)), err
+}
+
+func RunCmdPassthrough(name string, args ...string) error {
+	cmd := exec.Command(name, args...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Stdin = os.Stdin
+	return cmd.Run()
+}
+
+// ExtractVersion pulls the first version-like string (digits and dots)
+// out of text, e.g. turning "git version 2.43.0.windows.1" into "2.43.0.".
+func ExtractVersion(s string) string {
+	start := -1
+	for i, c := range s {
+		if c >= '0' && c <= '9' {
+			if start == -1 {
+				start = i
+			}
+		} else if c == '.' && start != -1 {
+			continue
+		} else if start != -1 {
+			return s[start:i]
+		}
+	}
+	if start != -1 {
+		return s[start:]
+	}
+	return s
+}
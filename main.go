@@ -0,0 +1,17 @@
+//go:build windows
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/MuiGoku123432/windows-dev-setup/cmd"
+)
+
+func main() {
+	if err := cmd.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}